@@ -1,28 +1,97 @@
 package bbgo
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	"github.com/c9s/bbgo/pkg/indicator"
 	"github.com/c9s/bbgo/pkg/types"
 )
 
+// MACDSetting identifies a MACD indicator by its interval and the three
+// periods it is configured with, since those don't fit the plain
+// IntervalWindow key used by the other indicators.
+type MACDSetting struct {
+	Interval     types.Interval
+	FastPeriod   int
+	SlowPeriod   int
+	SignalPeriod int
+}
+
+// IchimokuSetting identifies an Ichimoku Cloud indicator by its interval and
+// the three periods (conversion, base, leading span) it is configured with.
+type IchimokuSetting struct {
+	Interval          types.Interval
+	ConversionPeriod  int
+	BasePeriod        int
+	LeadingSpanPeriod int
+}
+
 type StandardIndicatorSet struct {
 	Symbol string
 	// Standard indicators
 	// interval -> window
-	SMA  map[types.IntervalWindow]*indicator.SMA
-	EWMA map[types.IntervalWindow]*indicator.EWMA
-	BOLL map[types.IntervalWindow]*indicator.BOLL
+	SMA      map[types.IntervalWindow]*indicator.SMA
+	EWMA     map[types.IntervalWindow]*indicator.EWMA
+	BOLL     map[types.IntervalWindow]*indicator.BOLL
+	RSI      map[types.IntervalWindow]*indicator.RSI
+	ATR      map[types.IntervalWindow]*indicator.ATR
+	ADX      map[types.IntervalWindow]*indicator.ADX
+	VWAP     map[types.IntervalWindow]*indicator.VWAP
+	OBV      map[types.IntervalWindow]*indicator.OBV
+	STOCH    map[types.IntervalWindow]*indicator.STOCH
+	MACD     map[MACDSetting]*indicator.MACD
+	Ichimoku map[IchimokuSetting]*indicator.Ichimoku
+
+	// customIndicatorFactories holds the factories registered via
+	// RegisterIndicator, keyed by indicator name.
+	customIndicatorFactories map[string]func(iw types.IntervalWindow) indicator.Indicator
+
+	// customIndicators caches the instances created from the factories
+	// above, keyed by indicator name and then by interval/window.
+	customIndicators map[string]map[types.IntervalWindow]indicator.Indicator
+
+	// historicalKLines caches the klines fetched by the last Warmup call,
+	// keyed by interval, so that indicators obtained lazily via Get* after
+	// Warmup has already run are backfilled too.
+	historicalKLines map[types.Interval][]types.KLine
+
+	// usedIntervals tracks the intervals a strategy has actually requested
+	// an indicator for, via one of the Get* methods (or GetIndicator). It
+	// deliberately excludes the intervals NewStandardIndicatorSet
+	// pre-seeds SMA/EWMA/BOLL/RSI/ATR/ADX for, since those are bound
+	// speculatively for every types.SupportedIntervals entry and most of
+	// them are never queried by a given strategy. Warmup uses this, not
+	// the pre-seeded maps, to decide which intervals are worth fetching
+	// history for.
+	usedIntervals map[types.Interval]struct{}
 
 	store *MarketDataStore
 }
 
 func NewStandardIndicatorSet(symbol string, store *MarketDataStore) *StandardIndicatorSet {
 	set := &StandardIndicatorSet{
-		Symbol: symbol,
-		SMA:    make(map[types.IntervalWindow]*indicator.SMA),
-		EWMA:   make(map[types.IntervalWindow]*indicator.EWMA),
-		BOLL:   make(map[types.IntervalWindow]*indicator.BOLL),
-		store:  store,
+		Symbol:   symbol,
+		SMA:      make(map[types.IntervalWindow]*indicator.SMA),
+		EWMA:     make(map[types.IntervalWindow]*indicator.EWMA),
+		BOLL:     make(map[types.IntervalWindow]*indicator.BOLL),
+		RSI:      make(map[types.IntervalWindow]*indicator.RSI),
+		ATR:      make(map[types.IntervalWindow]*indicator.ATR),
+		ADX:      make(map[types.IntervalWindow]*indicator.ADX),
+		VWAP:     make(map[types.IntervalWindow]*indicator.VWAP),
+		OBV:      make(map[types.IntervalWindow]*indicator.OBV),
+		STOCH:    make(map[types.IntervalWindow]*indicator.STOCH),
+		MACD:     make(map[MACDSetting]*indicator.MACD),
+		Ichimoku: make(map[IchimokuSetting]*indicator.Ichimoku),
+
+		customIndicatorFactories: make(map[string]func(iw types.IntervalWindow) indicator.Indicator),
+		customIndicators:         make(map[string]map[types.IntervalWindow]indicator.Indicator),
+
+		historicalKLines: make(map[types.Interval][]types.KLine),
+		usedIntervals:    make(map[types.Interval]struct{}),
+
+		store: store,
 	}
 
 	// let us pre-defined commonly used intervals
@@ -42,6 +111,17 @@ func NewStandardIndicatorSet(symbol string, store *MarketDataStore) *StandardInd
 		iw := types.IntervalWindow{Interval: interval, Window: 21}
 		set.BOLL[iw] = &indicator.BOLL{IntervalWindow: iw, K: 2.0}
 		set.BOLL[iw].Bind(store)
+
+		// RSI and ATR default to the common 14-period window
+		rsiIw := types.IntervalWindow{Interval: interval, Window: 14}
+		set.RSI[rsiIw] = &indicator.RSI{IntervalWindow: rsiIw}
+		set.RSI[rsiIw].Bind(store)
+
+		set.ATR[rsiIw] = &indicator.ATR{IntervalWindow: rsiIw}
+		set.ATR[rsiIw].Bind(store)
+
+		set.ADX[rsiIw] = &indicator.ADX{IntervalWindow: rsiIw}
+		set.ADX[rsiIw].Bind(store)
 	}
 
 	return set
@@ -50,10 +130,13 @@ func NewStandardIndicatorSet(symbol string, store *MarketDataStore) *StandardInd
 // GetBOLL returns the bollinger band indicator of the given interval and the window,
 // Please note that the K for std dev is fixed and defaults to 2.0
 func (set *StandardIndicatorSet) GetBOLL(iw types.IntervalWindow, bandWidth float64) *indicator.BOLL {
+	set.usedIntervals[iw.Interval] = struct{}{}
+
 	inc, ok := set.BOLL[iw]
 	if !ok {
 		inc := &indicator.BOLL{IntervalWindow: iw, K: bandWidth}
 		inc.Bind(set.store)
+		set.replayHistory(iw, inc)
 		set.BOLL[iw] = inc
 	}
 
@@ -62,10 +145,13 @@ func (set *StandardIndicatorSet) GetBOLL(iw types.IntervalWindow, bandWidth floa
 
 // GetSMA returns the simple moving average indicator of the given interval and the window size.
 func (set *StandardIndicatorSet) GetSMA(iw types.IntervalWindow) *indicator.SMA {
+	set.usedIntervals[iw.Interval] = struct{}{}
+
 	inc, ok := set.SMA[iw]
 	if !ok {
 		inc := &indicator.SMA{IntervalWindow: iw}
 		inc.Bind(set.store)
+		set.replayHistory(iw, inc)
 		set.SMA[iw] = inc
 	}
 
@@ -74,16 +160,347 @@ func (set *StandardIndicatorSet) GetSMA(iw types.IntervalWindow) *indicator.SMA
 
 // GetEWMA returns the exponential weighed moving average indicator of the given interval and the window size.
 func (set *StandardIndicatorSet) GetEWMA(iw types.IntervalWindow) *indicator.EWMA {
+	set.usedIntervals[iw.Interval] = struct{}{}
+
 	inc, ok := set.EWMA[iw]
 	if !ok {
 		inc := &indicator.EWMA{IntervalWindow: iw}
 		inc.Bind(set.store)
+		set.replayHistory(iw, inc)
 		set.EWMA[iw] = inc
 	}
 
 	return inc
 }
 
+// GetRSI returns the relative strength index indicator of the given interval and the window size.
+func (set *StandardIndicatorSet) GetRSI(iw types.IntervalWindow) *indicator.RSI {
+	set.usedIntervals[iw.Interval] = struct{}{}
+
+	inc, ok := set.RSI[iw]
+	if !ok {
+		inc = &indicator.RSI{IntervalWindow: iw}
+		inc.Bind(set.store)
+		set.replayHistory(iw, inc)
+		set.RSI[iw] = inc
+	}
+
+	return inc
+}
+
+// GetATR returns the average true range indicator of the given interval and the window size.
+func (set *StandardIndicatorSet) GetATR(iw types.IntervalWindow) *indicator.ATR {
+	set.usedIntervals[iw.Interval] = struct{}{}
+
+	inc, ok := set.ATR[iw]
+	if !ok {
+		inc = &indicator.ATR{IntervalWindow: iw}
+		inc.Bind(set.store)
+		set.replayHistory(iw, inc)
+		set.ATR[iw] = inc
+	}
+
+	return inc
+}
+
+// GetADX returns the average directional index indicator of the given interval and the window size.
+func (set *StandardIndicatorSet) GetADX(iw types.IntervalWindow) *indicator.ADX {
+	set.usedIntervals[iw.Interval] = struct{}{}
+
+	inc, ok := set.ADX[iw]
+	if !ok {
+		inc = &indicator.ADX{IntervalWindow: iw}
+		inc.Bind(set.store)
+		set.replayHistory(iw, inc)
+		set.ADX[iw] = inc
+	}
+
+	return inc
+}
+
+// GetVWAP returns the volume weighted average price indicator of the given interval and the window size.
+func (set *StandardIndicatorSet) GetVWAP(iw types.IntervalWindow) *indicator.VWAP {
+	set.usedIntervals[iw.Interval] = struct{}{}
+
+	inc, ok := set.VWAP[iw]
+	if !ok {
+		inc = &indicator.VWAP{IntervalWindow: iw}
+		inc.Bind(set.store)
+		set.replayHistory(iw, inc)
+		set.VWAP[iw] = inc
+	}
+
+	return inc
+}
+
+// GetOBV returns the on-balance volume indicator of the given interval and the window size.
+func (set *StandardIndicatorSet) GetOBV(iw types.IntervalWindow) *indicator.OBV {
+	set.usedIntervals[iw.Interval] = struct{}{}
+
+	inc, ok := set.OBV[iw]
+	if !ok {
+		inc = &indicator.OBV{IntervalWindow: iw}
+		inc.Bind(set.store)
+		set.replayHistory(iw, inc)
+		set.OBV[iw] = inc
+	}
+
+	return inc
+}
+
+// GetSTOCH returns the stochastic oscillator indicator of the given interval, window size and %D signal period.
+// Instances are cached by interval and window only: once a STOCH exists for iw,
+// later calls return it with the SignalPeriod it was created with, whatever signalPeriod they pass.
+func (set *StandardIndicatorSet) GetSTOCH(iw types.IntervalWindow, signalPeriod int) *indicator.STOCH {
+	set.usedIntervals[iw.Interval] = struct{}{}
+
+	inc, ok := set.STOCH[iw]
+	if !ok {
+		inc = &indicator.STOCH{IntervalWindow: iw, SignalPeriod: signalPeriod}
+		inc.Bind(set.store)
+		set.replayHistory(iw, inc)
+		set.STOCH[iw] = inc
+	}
+
+	return inc
+}
+
+// GetMACD returns the MACD indicator of the given fast/slow/signal periods and interval.
+func (set *StandardIndicatorSet) GetMACD(fastPeriod, slowPeriod, signalPeriod int, interval types.Interval) *indicator.MACD {
+	setting := MACDSetting{Interval: interval, FastPeriod: fastPeriod, SlowPeriod: slowPeriod, SignalPeriod: signalPeriod}
+	set.usedIntervals[interval] = struct{}{}
+
+	inc, ok := set.MACD[setting]
+	if !ok {
+		inc = &indicator.MACD{
+			// Window mirrors SlowPeriod, the slowest of the three EMAs, so
+			// IsReady() reflects when the MACD line itself has converged.
+			IntervalWindow: types.IntervalWindow{Interval: interval, Window: slowPeriod},
+			FastPeriod:     fastPeriod,
+			SlowPeriod:     slowPeriod,
+			SignalPeriod:   signalPeriod,
+		}
+		inc.Bind(set.store)
+		set.replayHistory(types.IntervalWindow{Interval: interval}, inc)
+		set.MACD[setting] = inc
+	}
+
+	return inc
+}
+
+// GetIchimoku returns the Ichimoku Cloud indicator of the given conversion/base/leading-span periods and interval.
+func (set *StandardIndicatorSet) GetIchimoku(conversionPeriod, basePeriod, leadingSpanPeriod int, interval types.Interval) *indicator.Ichimoku {
+	setting := IchimokuSetting{
+		Interval:          interval,
+		ConversionPeriod:  conversionPeriod,
+		BasePeriod:        basePeriod,
+		LeadingSpanPeriod: leadingSpanPeriod,
+	}
+	set.usedIntervals[interval] = struct{}{}
+
+	inc, ok := set.Ichimoku[setting]
+	if !ok {
+		inc = &indicator.Ichimoku{
+			// Window mirrors LeadingSpanPeriod, the longest of the three
+			// periods, so IsReady() reflects when the slowest line (Senkou
+			// Span B) has converged.
+			IntervalWindow:    types.IntervalWindow{Interval: interval, Window: leadingSpanPeriod},
+			ConversionPeriod:  conversionPeriod,
+			BasePeriod:        basePeriod,
+			LeadingSpanPeriod: leadingSpanPeriod,
+		}
+		inc.Bind(set.store)
+		set.replayHistory(types.IntervalWindow{Interval: interval}, inc)
+		set.Ichimoku[setting] = inc
+	}
+
+	return inc
+}
+
+// RegisterIndicator registers a custom indicator factory under the given
+// name, so that it can later be retrieved (and lazily instantiated, bound
+// and cached per IntervalWindow) through GetIndicator. This lets strategies
+// plug in their own indicators (Hull MA, KAMA, SuperTrend, composites, ...)
+// without modifying StandardIndicatorSet itself.
+func (set *StandardIndicatorSet) RegisterIndicator(name string, factory func(iw types.IntervalWindow) indicator.Indicator) {
+	set.customIndicatorFactories[name] = factory
+}
+
+// GetIndicator returns the custom indicator previously registered under name
+// for the given interval and window, creating and binding it on first use.
+func (set *StandardIndicatorSet) GetIndicator(name string, iw types.IntervalWindow) (indicator.Indicator, bool) {
+	factory, ok := set.customIndicatorFactories[name]
+	if !ok {
+		return nil, false
+	}
+
+	set.usedIntervals[iw.Interval] = struct{}{}
+
+	instances, ok := set.customIndicators[name]
+	if !ok {
+		instances = make(map[types.IntervalWindow]indicator.Indicator)
+		set.customIndicators[name] = instances
+	}
+
+	inc, ok := instances[iw]
+	if !ok {
+		inc = factory(iw)
+		inc.Bind(set.store)
+		set.replayHistory(iw, inc)
+		instances[iw] = inc
+	}
+
+	return inc, true
+}
+
+// binder is implemented by every concrete indicator type (SMA, EWMA, BOLL,
+// RSI, ...); it's the subset of indicator.Indicator that replayKLines needs.
+type binder interface {
+	Bind(updater indicator.KLineWindowUpdater)
+}
+
+// historicalReplay is a throwaway indicator.KLineWindowUpdater used to feed
+// previously queried klines directly into a single indicator. Unlike routing
+// replay klines through the live MarketDataStore, this can't double-feed
+// other indicators already bound to that store, and doesn't depend on
+// whether MarketDataStore.AddKLine re-emits buffered klines to its
+// subscribers - it drives the indicator's own Bind callback directly.
+type historicalReplay struct {
+	cb func(kLine types.KLine)
+}
+
+func (r *historicalReplay) OnKLineClosed(cb func(kLine types.KLine)) {
+	r.cb = cb
+}
+
+func (r *historicalReplay) feed(klines []types.KLine) {
+	for _, kLine := range klines {
+		r.cb(kLine)
+	}
+}
+
+// replayKLines feeds klines into inc by binding it to a throwaway
+// historicalReplay updater. It's safe to call on an indicator that is
+// already bound to the live MarketDataStore: the replay only runs once,
+// synchronously, against this one indicator.
+func replayKLines(inc binder, klines []types.KLine) {
+	if len(klines) == 0 {
+		return
+	}
+
+	replay := &historicalReplay{}
+	inc.Bind(replay)
+	replay.feed(klines)
+}
+
+// replayHistory replays any klines cached by a previous Warmup call for iw's
+// interval into inc, so that indicators created lazily (via Get*) after
+// Warmup has already run still start out warmed up.
+func (set *StandardIndicatorSet) replayHistory(iw types.IntervalWindow, inc binder) {
+	if klines, ok := set.historicalKLines[iw.Interval]; ok {
+		replayKLines(inc, klines)
+	}
+}
+
+// Warmup fetches `lookback` historical klines for every interval the
+// strategy has actually requested an indicator for via Get* or GetIndicator
+// (see usedIntervals), and replays them directly into the indicators bound
+// to that interval (see replayKLines), so that Last() returns a meaningful
+// value immediately after a strategy starts instead of only after enough
+// live klines have streamed in. The fetched klines are cached so that
+// indicators obtained lazily via Get* after Warmup has already run are
+// backfilled too (see replayHistory). Indicators report IsReady() once
+// they've computed at least Window values; RSI, ATR and ADX use their first
+// klines to seed their averages before computing a value, so they need more
+// than Window historical or live klines to become ready.
+//
+// A QueryKLines failure for one interval does not stop the rest from being
+// warmed up: Warmup keeps going and returns a single error aggregating every
+// interval that failed, so a caller can decide whether partially-warmed
+// indicators are acceptable or the strategy should abort startup.
+func (set *StandardIndicatorSet) Warmup(ctx context.Context, exchange types.Exchange, lookback int) error {
+	var failures []string
+
+	for interval := range set.usedIntervals {
+		klines, err := exchange.QueryKLines(ctx, set.Symbol, interval, types.KLineQueryOptions{Limit: lookback})
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", interval, err))
+			continue
+		}
+
+		set.historicalKLines[interval] = klines
+
+		for iw, inc := range set.SMA {
+			if iw.Interval == interval {
+				replayKLines(inc, klines)
+			}
+		}
+		for iw, inc := range set.EWMA {
+			if iw.Interval == interval {
+				replayKLines(inc, klines)
+			}
+		}
+		for iw, inc := range set.BOLL {
+			if iw.Interval == interval {
+				replayKLines(inc, klines)
+			}
+		}
+		for iw, inc := range set.RSI {
+			if iw.Interval == interval {
+				replayKLines(inc, klines)
+			}
+		}
+		for iw, inc := range set.ATR {
+			if iw.Interval == interval {
+				replayKLines(inc, klines)
+			}
+		}
+		for iw, inc := range set.ADX {
+			if iw.Interval == interval {
+				replayKLines(inc, klines)
+			}
+		}
+		for iw, inc := range set.VWAP {
+			if iw.Interval == interval {
+				replayKLines(inc, klines)
+			}
+		}
+		for iw, inc := range set.OBV {
+			if iw.Interval == interval {
+				replayKLines(inc, klines)
+			}
+		}
+		for iw, inc := range set.STOCH {
+			if iw.Interval == interval {
+				replayKLines(inc, klines)
+			}
+		}
+		for setting, inc := range set.MACD {
+			if setting.Interval == interval {
+				replayKLines(inc, klines)
+			}
+		}
+		for setting, inc := range set.Ichimoku {
+			if setting.Interval == interval {
+				replayKLines(inc, klines)
+			}
+		}
+		for _, instances := range set.customIndicators {
+			for iw, inc := range instances {
+				if iw.Interval == interval {
+					replayKLines(inc, klines)
+				}
+			}
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to warm up %d interval(s): %s", len(failures), strings.Join(failures, "; "))
+	}
+
+	return nil
+}
+
 // ExchangeSession presents the exchange connection Session
 // It also maintains and collects the data returned from the stream.
 type ExchangeSession struct {
@@ -112,6 +529,11 @@ type ExchangeSession struct {
 
 	lastPrices map[string]float64
 
+	// bestBids and bestAsks track the most recent top-of-book price per
+	// symbol, used by SessionGroup to compare venues for arbitrage.
+	bestBids map[string]float64
+	bestAsks map[string]float64
+
 	// Trades collects the executed trades from the exchange
 	// map: symbol -> []trade
 	Trades map[string][]types.Trade
@@ -143,6 +565,8 @@ func NewExchangeSession(name string, exchange types.Exchange) *ExchangeSession {
 		markets:               make(map[string]types.Market),
 		startPrices:           make(map[string]float64),
 		lastPrices:            make(map[string]float64),
+		bestBids:              make(map[string]float64),
+		bestAsks:              make(map[string]float64),
 		marketDataStores:      make(map[string]*MarketDataStore),
 		standardIndicatorSets: make(map[string]*StandardIndicatorSet),
 
@@ -171,6 +595,27 @@ func (session *ExchangeSession) LastPrice(symbol string) (price float64, ok bool
 	return price, ok
 }
 
+// BestBid returns the most recent top-of-book bid price for the given symbol.
+func (session *ExchangeSession) BestBid(symbol string) (price float64, ok bool) {
+	price, ok = session.bestBids[symbol]
+	return price, ok
+}
+
+// BestAsk returns the most recent top-of-book ask price for the given symbol.
+func (session *ExchangeSession) BestAsk(symbol string) (price float64, ok bool) {
+	price, ok = session.bestAsks[symbol]
+	return price, ok
+}
+
+// updateBestBidAsk refreshes the top-of-book prices used by SessionGroup for
+// cross-exchange comparisons. Until sessions stream real L2 book depth, it is
+// driven by SessionGroup.bindPriceTracking polling the venue's ticker on
+// each closed kline rather than by a genuine book update handler.
+func (session *ExchangeSession) updateBestBidAsk(symbol string, bid, ask float64) {
+	session.bestBids[symbol] = bid
+	session.bestAsks[symbol] = ask
+}
+
 func (session *ExchangeSession) Market(symbol string) (market types.Market, ok bool) {
 	market, ok = session.markets[symbol]
 	return market, ok
@@ -0,0 +1,182 @@
+package bbgo
+
+import (
+	"context"
+
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// SessionGroup aggregates multiple ExchangeSessions that all trade the same
+// symbol on different exchanges. It gives cross-exchange strategies a single
+// place to subscribe to market data and compare venues, instead of having
+// each strategy correlate sessions by hand.
+//
+// The sessions in this tree don't stream full L2 order book depth yet, so
+// there is no merged order book here — BestBid/BestAsk give a merged
+// top-of-book view instead, derived from each venue's own ticker (wired up
+// by bindPriceTracking below). Once a session streams real book updates,
+// that handler should feed ExchangeSession.updateBestBidAsk instead of the
+// ticker poll.
+type SessionGroup struct {
+	Symbol string
+
+	// sessions holds the member sessions keyed by session name.
+	sessions map[string]*ExchangeSession
+
+	// boundSessions tracks which sessions already have price tracking wired
+	// up, so AddSession/Subscribe don't double-subscribe a session.
+	boundSessions map[string]struct{}
+
+	spreadCallbacks []func(bidVenue, askVenue string, spread float64)
+}
+
+// NewSessionGroup creates a SessionGroup for the given symbol out of the
+// provided exchange sessions.
+func NewSessionGroup(symbol string, sessions ...*ExchangeSession) *SessionGroup {
+	group := &SessionGroup{
+		Symbol:        symbol,
+		sessions:      make(map[string]*ExchangeSession),
+		boundSessions: make(map[string]struct{}),
+	}
+
+	for _, session := range sessions {
+		group.AddSession(session)
+	}
+
+	return group
+}
+
+// AddSession adds an exchange session to the group and wires its price
+// tracking if the session's market data store for the group's symbol is
+// already available.
+func (g *SessionGroup) AddSession(session *ExchangeSession) {
+	g.sessions[session.Name] = session
+	g.bindPriceTracking(session)
+}
+
+// Sessions returns the member sessions of the group.
+func (g *SessionGroup) Sessions() map[string]*ExchangeSession {
+	return g.sessions
+}
+
+// Subscribe subscribes to the given channel/options for the group's symbol
+// on every member session, then (re-)tries to wire price tracking, since
+// subscribing is usually what causes a session's market data store to be
+// allocated.
+func (g *SessionGroup) Subscribe(channel types.Channel, options types.SubscribeOptions) *SessionGroup {
+	for _, session := range g.sessions {
+		session.Subscribe(channel, g.Symbol, options)
+		g.bindPriceTracking(session)
+	}
+
+	return g
+}
+
+// bindPriceTracking subscribes to the session's kline stream for the
+// group's symbol and, on every closed kline, queries the venue's ticker and
+// feeds its real best bid/ask into ExchangeSession.updateBestBidAsk. This is
+// what actually keeps BestBid/BestAsk/CheckSpread live; without it nothing
+// ever calls updateBestBidAsk and the spread detection below would never
+// fire.
+//
+// A kline close is only used as the trigger to refresh the quote, not as
+// the quote itself: a last trade price is not an executable bid or ask, so
+// feeding it into both sides would make CheckSpread fire on ordinary
+// cross-venue price noise instead of a real, tradeable spread.
+func (g *SessionGroup) bindPriceTracking(session *ExchangeSession) {
+	if _, ok := g.boundSessions[session.Name]; ok {
+		return
+	}
+
+	store, ok := session.MarketDataStore(g.Symbol)
+	if !ok {
+		return
+	}
+
+	store.OnKLineClosed(func(kLine types.KLine) {
+		ticker, err := session.Exchange.QueryTicker(context.Background(), g.Symbol)
+		if err != nil {
+			return
+		}
+
+		session.updateBestBidAsk(g.Symbol, ticker.Buy.Float64(), ticker.Sell.Float64())
+		g.CheckSpread()
+	})
+
+	g.boundSessions[session.Name] = struct{}{}
+}
+
+// MarketDataStore returns the market data store of the group's symbol for
+// the given venue (session name). It is per-venue, not a merged view — use
+// BestBid/BestAsk for a merged top-of-book comparison across venues.
+func (g *SessionGroup) MarketDataStore(venue string) (*MarketDataStore, bool) {
+	session, ok := g.sessions[venue]
+	if !ok {
+		return nil, false
+	}
+
+	return session.MarketDataStore(g.Symbol)
+}
+
+// BestBid returns the highest bid price for the group's symbol across all
+// member sessions, along with the venue (session name) quoting it.
+func (g *SessionGroup) BestBid() (price float64, venue string, ok bool) {
+	for name, session := range g.sessions {
+		bid, bidOk := session.BestBid(g.Symbol)
+		if !bidOk {
+			continue
+		}
+
+		if !ok || bid > price {
+			price, venue, ok = bid, name, true
+		}
+	}
+
+	return price, venue, ok
+}
+
+// BestAsk returns the lowest ask price for the group's symbol across all
+// member sessions, along with the venue (session name) quoting it.
+func (g *SessionGroup) BestAsk() (price float64, venue string, ok bool) {
+	for name, session := range g.sessions {
+		ask, askOk := session.BestAsk(g.Symbol)
+		if !askOk {
+			continue
+		}
+
+		if !ok || ask < price {
+			price, venue, ok = ask, name, true
+		}
+	}
+
+	return price, venue, ok
+}
+
+// OnSpread registers a callback fired whenever CheckSpread finds the best
+// bid (on bidVenue) quoting higher than the best ask (on askVenue), i.e. a
+// cross-exchange arbitrage opportunity. spread is bid price - ask price.
+func (g *SessionGroup) OnSpread(cb func(bidVenue, askVenue string, spread float64)) {
+	g.spreadCallbacks = append(g.spreadCallbacks, cb)
+}
+
+// CheckSpread compares the current best bid and best ask across the group's
+// sessions and fires the registered OnSpread callbacks if the bid venue and
+// ask venue differ and the bid crosses the ask. It is called automatically
+// by bindPriceTracking on every closed kline, so strategies don't need to
+// call it themselves.
+func (g *SessionGroup) CheckSpread() {
+	bid, bidVenue, bidOk := g.BestBid()
+	ask, askVenue, askOk := g.BestAsk()
+	if !bidOk || !askOk || bidVenue == askVenue {
+		return
+	}
+
+	spread := bid - ask
+	if spread <= 0 {
+		return
+	}
+
+	for _, cb := range g.spreadCallbacks {
+		cb(bidVenue, askVenue, spread)
+	}
+}
@@ -0,0 +1,69 @@
+package bbgo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// fakeExchange is the minimal types.Exchange needed to construct an
+// ExchangeSession in tests, without a real exchange connection.
+type fakeExchange struct{}
+
+func (fakeExchange) NewStream() types.Stream { return nil }
+
+func (fakeExchange) QueryKLines(ctx context.Context, symbol string, interval types.Interval, options types.KLineQueryOptions) ([]types.KLine, error) {
+	return nil, nil
+}
+
+func (fakeExchange) QueryTicker(ctx context.Context, symbol string) (*types.Ticker, error) {
+	return nil, nil
+}
+
+// TestSessionGroup_CheckSpread exercises the spread-detection logic directly
+// via updateBestBidAsk, the same entry point bindPriceTracking drives off of
+// each venue's kline closes, without depending on a concrete MarketDataStore.
+func TestSessionGroup_CheckSpread(t *testing.T) {
+	binance := NewExchangeSession("binance", fakeExchange{})
+	kucoin := NewExchangeSession("kucoin", fakeExchange{})
+
+	group := NewSessionGroup("BTCUSDT", binance, kucoin)
+
+	var gotBidVenue, gotAskVenue string
+	var gotSpread float64
+	fired := 0
+	group.OnSpread(func(bidVenue, askVenue string, spread float64) {
+		fired++
+		gotBidVenue, gotAskVenue, gotSpread = bidVenue, askVenue, spread
+	})
+
+	// No cross yet: the best bid (binance, 99) is still below the best ask
+	// (kucoin, 100) across venues.
+	binance.updateBestBidAsk("BTCUSDT", 99, 105)
+	kucoin.updateBestBidAsk("BTCUSDT", 98, 100)
+	group.CheckSpread()
+	if fired != 0 {
+		t.Fatalf("OnSpread fired %d times before a cross, want 0", fired)
+	}
+
+	// binance's bid now crosses above kucoin's ask: arbitrage opportunity.
+	binance.updateBestBidAsk("BTCUSDT", 103, 105)
+	group.CheckSpread()
+	if fired != 1 {
+		t.Fatalf("OnSpread fired %d times after a cross, want 1", fired)
+	}
+	if gotBidVenue != "binance" || gotAskVenue != "kucoin" {
+		t.Errorf("bidVenue/askVenue = %s/%s, want binance/kucoin", gotBidVenue, gotAskVenue)
+	}
+	// bid (103) - ask (100) = 3
+	almostEqualBBGO(t, "spread", gotSpread, 3)
+}
+
+func almostEqualBBGO(t *testing.T, name string, got, want float64) {
+	t.Helper()
+	const epsilon = 1e-9
+	if d := got - want; d > epsilon || d < -epsilon {
+		t.Errorf("%s = %v, want %v", name, got, want)
+	}
+}
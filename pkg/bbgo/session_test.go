@@ -0,0 +1,86 @@
+package bbgo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// recordingExchange wraps fakeExchange to record every QueryKLines call and
+// optionally fail specific intervals, for exercising Warmup's
+// interval-scoping and partial-failure behavior.
+type recordingExchange struct {
+	fakeExchange
+	queried map[types.Interval]int
+	failing map[types.Interval]error
+}
+
+func newRecordingExchange() *recordingExchange {
+	return &recordingExchange{
+		queried: make(map[types.Interval]int),
+		failing: make(map[types.Interval]error),
+	}
+}
+
+func (e *recordingExchange) QueryKLines(ctx context.Context, symbol string, interval types.Interval, options types.KLineQueryOptions) ([]types.KLine, error) {
+	e.queried[interval]++
+	if err, ok := e.failing[interval]; ok {
+		return nil, err
+	}
+	return []types.KLine{{Interval: interval}}, nil
+}
+
+// TestStandardIndicatorSet_WarmupScopesToUsedIntervals checks that Warmup
+// only queries klines for intervals a strategy actually requested an
+// indicator for via Get*, not every interval NewStandardIndicatorSet
+// pre-seeds SMA/EWMA/BOLL/RSI/ATR/ADX for.
+func TestStandardIndicatorSet_WarmupScopesToUsedIntervals(t *testing.T) {
+	set := NewStandardIndicatorSet("BTCUSDT", &MarketDataStore{})
+
+	set.GetRSI(types.IntervalWindow{Interval: types.Interval1m, Window: 14})
+
+	exchange := newRecordingExchange()
+	if err := set.Warmup(context.Background(), exchange, 100); err != nil {
+		t.Fatalf("Warmup() error = %v", err)
+	}
+
+	if got := exchange.queried[types.Interval1m]; got != 1 {
+		t.Errorf("QueryKLines(1m) called %d times, want 1", got)
+	}
+	if len(exchange.queried) != 1 {
+		t.Errorf("QueryKLines called for %d interval(s), want 1 (only the interval actually requested via GetRSI)", len(exchange.queried))
+	}
+}
+
+// TestStandardIndicatorSet_WarmupContinuesPastFailures checks that a
+// QueryKLines failure for one interval doesn't stop Warmup from fetching
+// and replaying the others, and that every failure is reported back in a
+// single aggregated error.
+func TestStandardIndicatorSet_WarmupContinuesPastFailures(t *testing.T) {
+	const fiveMinutes types.Interval = "5m"
+
+	set := NewStandardIndicatorSet("BTCUSDT", &MarketDataStore{})
+	set.GetRSI(types.IntervalWindow{Interval: types.Interval1m, Window: 14})
+	set.GetRSI(types.IntervalWindow{Interval: fiveMinutes, Window: 14})
+
+	exchange := newRecordingExchange()
+	exchange.failing[types.Interval1m] = errors.New("boom")
+
+	err := set.Warmup(context.Background(), exchange, 100)
+	if err == nil {
+		t.Fatal("Warmup() error = nil, want a non-nil aggregated error")
+	}
+	if !strings.Contains(err.Error(), "1m") || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("Warmup() error = %q, want it to mention the failing interval and underlying error", err.Error())
+	}
+
+	if got := exchange.queried[fiveMinutes]; got != 1 {
+		t.Errorf("QueryKLines(5m) called %d times, want 1 even though 1m failed", got)
+	}
+	if _, ok := set.historicalKLines[fiveMinutes]; !ok {
+		t.Errorf("historicalKLines[5m] not cached even though its query succeeded")
+	}
+}
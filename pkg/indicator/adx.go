@@ -0,0 +1,165 @@
+package indicator
+
+import (
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// ADX is the Average Directional Index indicator, used to quantify trend
+// strength regardless of its direction.
+// For more details, please check out the wikipedia page:
+// https://en.wikipedia.org/wiki/Average_directional_movement_index
+type ADX struct {
+	types.IntervalWindow
+	updateNotifier
+
+	Values types.Float64Slice
+
+	prevHigh, prevLow, prevClose float64
+	initialized                  bool
+
+	// trBuffer/plusDMBuffer/minusDMBuffer accumulate the first Window true
+	// ranges and directional movements so the moving averages can be seeded
+	// from their simple average, as Wilder's method requires, instead of
+	// starting from a single period.
+	trBuffer, plusDMBuffer, minusDMBuffer []float64
+	avgTrueRng, avgPlusDM, avgMinusDM     float64
+	seeded                                bool
+
+	// dxBuffer accumulates the first Window DX values so avgDX (the ADX
+	// line itself) can likewise be seeded from their simple average.
+	dxBuffer []float64
+	avgDX    float64
+	dxSeeded bool
+}
+
+func (inc *ADX) update(kLine types.KLine) {
+	high := kLine.High.Float64()
+	low := kLine.Low.Float64()
+	closePrice := kLine.Close.Float64()
+
+	if !inc.initialized {
+		inc.prevHigh = high
+		inc.prevLow = low
+		inc.prevClose = closePrice
+		inc.initialized = true
+		return
+	}
+
+	upMove := high - inc.prevHigh
+	downMove := inc.prevLow - low
+
+	plusDM, minusDM := 0.0, 0.0
+	if upMove > downMove && upMove > 0 {
+		plusDM = upMove
+	}
+	if downMove > upMove && downMove > 0 {
+		minusDM = downMove
+	}
+
+	tr := trueRange(high, low, inc.prevClose)
+
+	inc.prevHigh = high
+	inc.prevLow = low
+	inc.prevClose = closePrice
+
+	if !inc.seeded {
+		inc.trBuffer = append(inc.trBuffer, tr)
+		inc.plusDMBuffer = append(inc.plusDMBuffer, plusDM)
+		inc.minusDMBuffer = append(inc.minusDMBuffer, minusDM)
+		if len(inc.trBuffer) < inc.Window {
+			return
+		}
+
+		trSum, plusSum, minusSum := 0.0, 0.0, 0.0
+		for i := range inc.trBuffer {
+			trSum += inc.trBuffer[i]
+			plusSum += inc.plusDMBuffer[i]
+			minusSum += inc.minusDMBuffer[i]
+		}
+		n := float64(inc.Window)
+		inc.avgTrueRng = trSum / n
+		inc.avgPlusDM = plusSum / n
+		inc.avgMinusDM = minusSum / n
+		inc.seeded = true
+		inc.trBuffer, inc.plusDMBuffer, inc.minusDMBuffer = nil, nil, nil
+	} else {
+		n := float64(inc.Window)
+		inc.avgTrueRng = (inc.avgTrueRng*(n-1) + tr) / n
+		inc.avgPlusDM = (inc.avgPlusDM*(n-1) + plusDM) / n
+		inc.avgMinusDM = (inc.avgMinusDM*(n-1) + minusDM) / n
+	}
+
+	plusDI, minusDI := 0.0, 0.0
+	if inc.avgTrueRng != 0 {
+		plusDI = inc.avgPlusDM / inc.avgTrueRng * 100.0
+		minusDI = inc.avgMinusDM / inc.avgTrueRng * 100.0
+	}
+
+	dx := 0.0
+	if sum := plusDI + minusDI; sum != 0 {
+		dx = (plusDI - minusDI) / sum * 100.0
+		if dx < 0 {
+			dx = -dx
+		}
+	}
+
+	if !inc.dxSeeded {
+		inc.dxBuffer = append(inc.dxBuffer, dx)
+		if len(inc.dxBuffer) < inc.Window {
+			return
+		}
+
+		sum := 0.0
+		for _, v := range inc.dxBuffer {
+			sum += v
+		}
+		inc.avgDX = sum / float64(inc.Window)
+		inc.dxSeeded = true
+		inc.dxBuffer = nil
+	} else {
+		n := float64(inc.Window)
+		inc.avgDX = (inc.avgDX*(n-1) + dx) / n
+	}
+	inc.Values.Push(inc.avgDX)
+}
+
+func (inc *ADX) Last() float64 {
+	if len(inc.Values) == 0 {
+		return 0.0
+	}
+	return inc.Values[len(inc.Values)-1]
+}
+
+func (inc *ADX) Index(i int) float64 {
+	length := len(inc.Values)
+	if i >= length {
+		return 0.0
+	}
+	return inc.Values[length-i-1]
+}
+
+func (inc *ADX) Length() int {
+	return len(inc.Values)
+}
+
+// IsReady returns true once the indicator has accumulated at least Window
+// samples, so strategies can gate signal generation until the underlying
+// average has actually converged instead of acting on a single sample.
+func (inc *ADX) IsReady() bool {
+	return inc.Length() >= inc.Window
+}
+
+func (inc *ADX) Bind(updater KLineWindowUpdater) {
+	updater.OnKLineClosed(func(kLine types.KLine) {
+		if kLine.Interval != inc.Interval {
+			return
+		}
+
+		inc.update(kLine)
+		if inc.Length() == 0 {
+			return
+		}
+
+		inc.emit(inc.Last(), kLine)
+	})
+}
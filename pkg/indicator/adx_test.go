@@ -0,0 +1,40 @@
+package indicator
+
+import (
+	"testing"
+
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// TestADX_Seeding checks ADX's two-stage Wilder seeding: the avgTrueRng/
+// avgPlusDM/avgMinusDM moving averages are seeded from the simple average of
+// the first Window true ranges/directional movements, and the ADX line
+// itself (avgDX) is in turn seeded from the simple average of the first
+// Window DX values computed from those, before either switches to Wilder's
+// recursive smoothing.
+func TestADX_Seeding(t *testing.T) {
+	inc := &ADX{IntervalWindow: types.IntervalWindow{Interval: types.Interval1m, Window: 2}}
+
+	type bar struct{ high, low, close float64 }
+	bars := []bar{
+		{10, 9, 9.5},    // seeds prevHigh/prevLow/prevClose only
+		{11, 9, 10},     // TR=2, +DM=1, -DM=0
+		{12, 10, 11},    // TR=2, +DM=1, -DM=0 -> seeds avgTrueRng/avgPlusDM/avgMinusDM; DX=100, buffered
+		{11, 10, 10.5},  // smoothed TR/DM; DX=100 -> dxBuffer full, seeds avgDX = (100+100)/2 = 100
+		{10.5, 9.5, 10}, // smoothed TR/DM; DX=0 -> avgDX = (100*1+0)/2 = 50
+	}
+	for _, b := range bars {
+		inc.update(newKLine(b.high, b.low, b.close, 0))
+	}
+
+	if inc.Length() != 2 {
+		t.Fatalf("Length() = %d, want 2 after 2 computed ADX values", inc.Length())
+	}
+
+	almostEqual(t, "ADX", inc.Last(), 50)
+	almostEqual(t, "ADX[1]", inc.Index(1), 100)
+
+	if !inc.IsReady() {
+		t.Errorf("IsReady() = false after Length() >= Window, want true")
+	}
+}
@@ -0,0 +1,123 @@
+package indicator
+
+import (
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// ATR is the Average True Range indicator, a measure of volatility computed
+// as a Wilder-smoothed moving average of the true range.
+// For more details, please check out the wikipedia page:
+// https://en.wikipedia.org/wiki/Average_true_range
+type ATR struct {
+	types.IntervalWindow
+	updateNotifier
+
+	Values types.Float64Slice
+
+	prevClose   float64
+	initialized bool
+
+	// trBuffer accumulates the first Window true ranges so the moving
+	// average can be seeded from their simple average, as Wilder's method
+	// requires, instead of starting from a single true range.
+	trBuffer   []float64
+	avgTrueRng float64
+	seeded     bool
+}
+
+func trueRange(high, low, prevClose float64) float64 {
+	tr := high - low
+	if d := high - prevClose; d < 0 {
+		d = -d
+		if d > tr {
+			tr = d
+		}
+	} else if d > tr {
+		tr = d
+	}
+	if d := low - prevClose; d < 0 {
+		d = -d
+		if d > tr {
+			tr = d
+		}
+	} else if d > tr {
+		tr = d
+	}
+	return tr
+}
+
+func (inc *ATR) update(kLine types.KLine) {
+	high := kLine.High.Float64()
+	low := kLine.Low.Float64()
+	closePrice := kLine.Close.Float64()
+
+	if !inc.initialized {
+		inc.prevClose = closePrice
+		inc.initialized = true
+		return
+	}
+
+	tr := trueRange(high, low, inc.prevClose)
+	inc.prevClose = closePrice
+
+	if !inc.seeded {
+		inc.trBuffer = append(inc.trBuffer, tr)
+		if len(inc.trBuffer) < inc.Window {
+			return
+		}
+
+		sum := 0.0
+		for _, v := range inc.trBuffer {
+			sum += v
+		}
+		inc.avgTrueRng = sum / float64(inc.Window)
+		inc.seeded = true
+		inc.trBuffer = nil
+	} else {
+		n := float64(inc.Window)
+		inc.avgTrueRng = (inc.avgTrueRng*(n-1) + tr) / n
+	}
+
+	inc.Values.Push(inc.avgTrueRng)
+}
+
+func (inc *ATR) Last() float64 {
+	if len(inc.Values) == 0 {
+		return 0.0
+	}
+	return inc.Values[len(inc.Values)-1]
+}
+
+func (inc *ATR) Index(i int) float64 {
+	length := len(inc.Values)
+	if i >= length {
+		return 0.0
+	}
+	return inc.Values[length-i-1]
+}
+
+func (inc *ATR) Length() int {
+	return len(inc.Values)
+}
+
+// IsReady returns true once the indicator has accumulated at least Window
+// samples, so strategies can gate signal generation until the underlying
+// average has actually converged instead of acting on a single sample.
+func (inc *ATR) IsReady() bool {
+	return inc.Length() >= inc.Window
+}
+
+func (inc *ATR) Bind(updater KLineWindowUpdater) {
+	updater.OnKLineClosed(func(kLine types.KLine) {
+		if kLine.Interval != inc.Interval {
+			return
+		}
+
+		inc.update(kLine)
+		if inc.Length() == 0 {
+			return
+		}
+
+		inc.emit(inc.Last(), kLine)
+	})
+}
@@ -0,0 +1,37 @@
+package indicator
+
+import (
+	"testing"
+
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// TestATR_Seeding checks that the moving average is seeded from the simple
+// average of the first Window true ranges (Wilder's method), not from a
+// single period.
+func TestATR_Seeding(t *testing.T) {
+	inc := &ATR{IntervalWindow: types.IntervalWindow{Interval: types.Interval1m, Window: 4}}
+
+	type bar struct{ high, low, close float64 }
+	bars := []bar{
+		{10, 9, 9.5},    // seeds prevClose only, no true range yet
+		{11, 9, 10},     // TR = max(2, |11-9.5|=1.5, |9-9.5|=0.5)   = 2
+		{10.5, 9.5, 10}, // TR = max(1, |10.5-10|=0.5, |9.5-10|=0.5) = 1
+		{10, 8, 9},      // TR = max(2, |10-10|=0, |8-10|=2)         = 2
+		{9.5, 8.5, 9},   // TR = max(1, |9.5-9|=0.5, |8.5-9|=0.5)    = 1
+	}
+	for _, b := range bars {
+		inc.update(newKLine(b.high, b.low, b.close, 0))
+	}
+
+	if inc.Length() != 1 {
+		t.Fatalf("Length() = %d, want 1 after exactly Window true ranges", inc.Length())
+	}
+
+	// avgTrueRng = (2+1+2+1)/4 = 1.5
+	almostEqual(t, "ATR", inc.Last(), 1.5)
+
+	if inc.IsReady() {
+		t.Errorf("IsReady() = true after a single computed value, want false until Length() >= Window")
+	}
+}
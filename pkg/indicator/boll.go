@@ -0,0 +1,96 @@
+package indicator
+
+import (
+	"math"
+
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// BOLL is the Bollinger Bands indicator: a Window-period SMA (the middle
+// band) plus/minus K standard deviations (the upper/lower bands).
+// For more details, please check out the wikipedia page:
+// https://en.wikipedia.org/wiki/Bollinger_Bands
+type BOLL struct {
+	types.IntervalWindow
+	updateNotifier
+
+	// K is the number of standard deviations the upper/lower bands sit from
+	// the middle band, commonly 2.0.
+	K float64
+
+	// Values holds the middle band (the SMA); UpperBand/LowerBand hold the
+	// corresponding band on each side of it.
+	Values    types.Float64Slice
+	UpperBand types.Float64Slice
+	LowerBand types.Float64Slice
+
+	window types.Float64Slice
+}
+
+func (inc *BOLL) update(kLine types.KLine) {
+	inc.window.Push(kLine.Close.Float64())
+	if len(inc.window) > inc.Window {
+		inc.window = inc.window[len(inc.window)-inc.Window:]
+	}
+
+	n := float64(len(inc.window))
+	sum := 0.0
+	for _, v := range inc.window {
+		sum += v
+	}
+	mean := sum / n
+
+	variance := 0.0
+	for _, v := range inc.window {
+		d := v - mean
+		variance += d * d
+	}
+	variance /= n
+	std := math.Sqrt(variance)
+
+	inc.Values.Push(mean)
+	inc.UpperBand.Push(mean + inc.K*std)
+	inc.LowerBand.Push(mean - inc.K*std)
+}
+
+// Last returns the latest middle band (SMA) value.
+func (inc *BOLL) Last() float64 {
+	if len(inc.Values) == 0 {
+		return 0.0
+	}
+	return inc.Values[len(inc.Values)-1]
+}
+
+func (inc *BOLL) Index(i int) float64 {
+	length := len(inc.Values)
+	if i >= length {
+		return 0.0
+	}
+	return inc.Values[length-i-1]
+}
+
+func (inc *BOLL) Length() int {
+	return len(inc.Values)
+}
+
+// IsReady returns true once the indicator has accumulated at least Window
+// samples, so strategies can gate signal generation until the underlying
+// average has actually converged instead of acting on a single sample.
+func (inc *BOLL) IsReady() bool {
+	return inc.Length() >= inc.Window
+}
+
+func (inc *BOLL) Bind(updater KLineWindowUpdater) {
+	updater.OnKLineClosed(func(kLine types.KLine) {
+		if kLine.Interval != inc.Interval {
+			return
+		}
+
+		inc.update(kLine)
+		if inc.Length() == 0 {
+			return
+		}
+
+		inc.emit(inc.Last(), kLine)
+	})
+}
@@ -0,0 +1,70 @@
+package indicator
+
+import (
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// Cross direction constants passed to the callbacks registered with CrossOver.OnCross.
+const (
+	CrossOverDirectionUp   = 1
+	CrossOverDirectionDown = -1
+)
+
+// CrossOver tracks the crossing relationship between two indicators and
+// notifies subscribers whenever a crosses over b (direction +1) or under b
+// (direction -1). It is built on top of OnUpdate so it reacts the instant
+// either side of the pair gets a new value.
+type CrossOver struct {
+	a, b Indicator
+
+	prevDiff    float64
+	initialized bool
+
+	callbacks []func(direction int)
+}
+
+// Cross returns a CrossOver tracker for the given pair of indicators. Call
+// OnCross on the result to subscribe to crossing events.
+func Cross(a, b Indicator) *CrossOver {
+	c := &CrossOver{a: a, b: b}
+
+	onUpdate := func(value float64, kLine types.KLine) {
+		c.check()
+	}
+	a.OnUpdate(onUpdate)
+	b.OnUpdate(onUpdate)
+
+	return c
+}
+
+func (c *CrossOver) check() {
+	diff := c.a.Last() - c.b.Last()
+
+	if !c.initialized {
+		c.prevDiff = diff
+		c.initialized = true
+		return
+	}
+
+	switch {
+	case c.prevDiff <= 0 && diff > 0:
+		c.fire(CrossOverDirectionUp)
+	case c.prevDiff >= 0 && diff < 0:
+		c.fire(CrossOverDirectionDown)
+	}
+
+	c.prevDiff = diff
+}
+
+func (c *CrossOver) fire(direction int) {
+	for _, cb := range c.callbacks {
+		cb(direction)
+	}
+}
+
+// OnCross registers a callback fired whenever a crosses b. direction is
+// CrossOverDirectionUp when a crosses above b, CrossOverDirectionDown when a
+// crosses below b.
+func (c *CrossOver) OnCross(cb func(direction int)) {
+	c.callbacks = append(c.callbacks, cb)
+}
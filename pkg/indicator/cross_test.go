@@ -0,0 +1,54 @@
+package indicator
+
+import (
+	"testing"
+
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// fakeIndicator is a minimal Indicator whose value is set directly by the
+// test, so CrossOver can be exercised without depending on a concrete
+// indicator's update math.
+type fakeIndicator struct {
+	updateNotifier
+	value float64
+}
+
+func (f *fakeIndicator) Bind(updater KLineWindowUpdater) {}
+func (f *fakeIndicator) Last() float64                   { return f.value }
+func (f *fakeIndicator) Index(i int) float64             { return f.value }
+func (f *fakeIndicator) Length() int                     { return 1 }
+func (f *fakeIndicator) IsReady() bool                   { return true }
+
+func (f *fakeIndicator) push(value float64) {
+	f.value = value
+	f.emit(value, types.KLine{})
+}
+
+// TestCrossOver checks that OnCross fires with the right direction exactly
+// when a crosses b, and stays silent otherwise.
+func TestCrossOver(t *testing.T) {
+	a := &fakeIndicator{}
+	b := &fakeIndicator{}
+	c := Cross(a, b)
+
+	var directions []int
+	c.OnCross(func(direction int) {
+		directions = append(directions, direction)
+	})
+
+	b.push(10) // seeds prevDiff, no event
+	a.push(5)  // a below b, no cross yet
+	a.push(15) // a crosses above b
+	a.push(8)  // a crosses below b
+
+	want := []int{CrossOverDirectionUp, CrossOverDirectionDown}
+	if len(directions) != len(want) {
+		t.Fatalf("directions = %v, want %v", directions, want)
+	}
+	for i := range want {
+		if directions[i] != want[i] {
+			t.Errorf("directions[%d] = %d, want %d", i, directions[i], want[i])
+		}
+	}
+}
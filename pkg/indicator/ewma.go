@@ -0,0 +1,71 @@
+package indicator
+
+import (
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// EWMA is the Exponentially Weighted Moving Average indicator, giving more
+// weight to recent close prices than a SMA of the same Window.
+// For more details, please check out the wikipedia page:
+// https://en.wikipedia.org/wiki/Moving_average#Exponential_moving_average
+type EWMA struct {
+	types.IntervalWindow
+	updateNotifier
+
+	Values types.Float64Slice
+
+	initialized bool
+}
+
+func (inc *EWMA) update(kLine types.KLine) {
+	price := kLine.Close.Float64()
+
+	if !inc.initialized {
+		inc.Values.Push(price)
+		inc.initialized = true
+		return
+	}
+
+	inc.Values.Push(ema(inc.Last(), price, inc.Window))
+}
+
+func (inc *EWMA) Last() float64 {
+	if len(inc.Values) == 0 {
+		return 0.0
+	}
+	return inc.Values[len(inc.Values)-1]
+}
+
+func (inc *EWMA) Index(i int) float64 {
+	length := len(inc.Values)
+	if i >= length {
+		return 0.0
+	}
+	return inc.Values[length-i-1]
+}
+
+func (inc *EWMA) Length() int {
+	return len(inc.Values)
+}
+
+// IsReady returns true once the indicator has accumulated at least Window
+// samples, so strategies can gate signal generation until the underlying
+// average has actually converged instead of acting on a single sample.
+func (inc *EWMA) IsReady() bool {
+	return inc.Length() >= inc.Window
+}
+
+func (inc *EWMA) Bind(updater KLineWindowUpdater) {
+	updater.OnKLineClosed(func(kLine types.KLine) {
+		if kLine.Interval != inc.Interval {
+			return
+		}
+
+		inc.update(kLine)
+		if inc.Length() == 0 {
+			return
+		}
+
+		inc.emit(inc.Last(), kLine)
+	})
+}
@@ -0,0 +1,34 @@
+package indicator
+
+import (
+	"testing"
+
+	"github.com/c9s/bbgo/pkg/fixedpoint"
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// newKLine builds a minimal 1m kline for indicator tests; only the fields
+// the indicators under test actually read need to be set.
+func newKLine(high, low, close, volume float64) types.KLine {
+	return types.KLine{
+		Interval: types.Interval1m,
+		High:     fixedpoint.NewFromFloat(high),
+		Low:      fixedpoint.NewFromFloat(low),
+		Close:    fixedpoint.NewFromFloat(close),
+		Volume:   fixedpoint.NewFromFloat(volume),
+	}
+}
+
+// closeAt builds a kline with only its close price set, for indicators (like
+// RSI) that only look at the close.
+func closeAt(price float64) types.KLine {
+	return types.KLine{Interval: types.Interval1m, Close: fixedpoint.NewFromFloat(price)}
+}
+
+func almostEqual(t *testing.T, name string, got, want float64) {
+	t.Helper()
+	const epsilon = 1e-6
+	if d := got - want; d > epsilon || d < -epsilon {
+		t.Errorf("%s = %v, want %v", name, got, want)
+	}
+}
@@ -0,0 +1,125 @@
+package indicator
+
+import (
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// Ichimoku is the Ichimoku Kinko Hyo (Ichimoku Cloud) indicator.
+//
+// Two simplifications compared to the full indicator: LeadingSpanA/
+// LeadingSpanB (the cloud) are pushed for the current bar rather than
+// displaced forward by BasePeriod, and the Chikou span (the close plotted
+// BasePeriod bars back) is not computed at all. Both are purely about where
+// a value is plotted/which extra line exists, not how the conversion/base
+// line or span math is computed, so charting code that expects the forward
+// displacement or the Chikou span needs to apply/compute it itself.
+// For more details, please check out the wikipedia page:
+// https://en.wikipedia.org/wiki/Ichimoku_Kink%C5%8D_Hy%C5%8D
+type Ichimoku struct {
+	types.IntervalWindow
+	updateNotifier
+
+	// ConversionPeriod is the window used for the Tenkan-sen (conversion line), commonly 9.
+	ConversionPeriod int
+
+	// BasePeriod is the window used for the Kijun-sen (base line), commonly 26.
+	BasePeriod int
+
+	// LeadingSpanPeriod is the window used for the Senkou Span B, commonly 52.
+	LeadingSpanPeriod int
+
+	ConversionLine types.Float64Slice
+	BaseLine       types.Float64Slice
+	LeadingSpanA   types.Float64Slice
+	LeadingSpanB   types.Float64Slice
+
+	highs, lows types.Float64Slice
+}
+
+func highLowMid(highs, lows types.Float64Slice, period int) float64 {
+	if len(highs) == 0 {
+		return 0.0
+	}
+
+	window := highs
+	lowWindow := lows
+	if len(window) > period {
+		window = window[len(window)-period:]
+		lowWindow = lowWindow[len(lowWindow)-period:]
+	}
+
+	highest, lowest := window[0], lowWindow[0]
+	for _, h := range window {
+		if h > highest {
+			highest = h
+		}
+	}
+	for _, l := range lowWindow {
+		if l < lowest {
+			lowest = l
+		}
+	}
+
+	return (highest + lowest) / 2.0
+}
+
+func (inc *Ichimoku) update(kLine types.KLine) {
+	inc.highs.Push(kLine.High.Float64())
+	inc.lows.Push(kLine.Low.Float64())
+
+	maxPeriod := inc.LeadingSpanPeriod
+	if len(inc.highs) > maxPeriod {
+		inc.highs = inc.highs[len(inc.highs)-maxPeriod:]
+		inc.lows = inc.lows[len(inc.lows)-maxPeriod:]
+	}
+
+	conversion := highLowMid(inc.highs, inc.lows, inc.ConversionPeriod)
+	base := highLowMid(inc.highs, inc.lows, inc.BasePeriod)
+
+	inc.ConversionLine.Push(conversion)
+	inc.BaseLine.Push(base)
+	inc.LeadingSpanA.Push((conversion + base) / 2.0)
+	inc.LeadingSpanB.Push(highLowMid(inc.highs, inc.lows, inc.LeadingSpanPeriod))
+}
+
+// Last returns the latest Senkou Span A (leading span A) value.
+func (inc *Ichimoku) Last() float64 {
+	if len(inc.LeadingSpanA) == 0 {
+		return 0.0
+	}
+	return inc.LeadingSpanA[len(inc.LeadingSpanA)-1]
+}
+
+func (inc *Ichimoku) Index(i int) float64 {
+	length := len(inc.LeadingSpanA)
+	if i >= length {
+		return 0.0
+	}
+	return inc.LeadingSpanA[length-i-1]
+}
+
+func (inc *Ichimoku) Length() int {
+	return len(inc.LeadingSpanA)
+}
+
+// IsReady returns true once the indicator has accumulated at least Window
+// samples, so strategies can gate signal generation until the underlying
+// average has actually converged instead of acting on a single sample.
+func (inc *Ichimoku) IsReady() bool {
+	return inc.Length() >= inc.Window
+}
+
+func (inc *Ichimoku) Bind(updater KLineWindowUpdater) {
+	updater.OnKLineClosed(func(kLine types.KLine) {
+		if kLine.Interval != inc.Interval {
+			return
+		}
+
+		inc.update(kLine)
+		if inc.Length() == 0 {
+			return
+		}
+
+		inc.emit(inc.Last(), kLine)
+	})
+}
@@ -0,0 +1,47 @@
+package indicator
+
+import (
+	"testing"
+
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// TestIchimoku_ReferenceValues checks the conversion/base lines and Senkou
+// spans against hand-computed (highest-high + lowest-low)/2 midpoints over
+// each line's period. LeadingSpanA/B are read at the current bar, since
+// they are not displaced forward by BasePeriod, and the final assertion
+// checks that a value is pushed for every bar; see the doc comment on
+// Ichimoku, which also notes that the Chikou span is not computed.
+func TestIchimoku_ReferenceValues(t *testing.T) {
+	inc := &Ichimoku{
+		IntervalWindow:    types.IntervalWindow{Interval: types.Interval1m, Window: 3},
+		ConversionPeriod:  2,
+		BasePeriod:        3,
+		LeadingSpanPeriod: 3,
+	}
+
+	bars := []struct{ high, low float64 }{
+		{10, 8},
+		{12, 9},
+		{11, 7},
+	}
+	for _, b := range bars {
+		inc.update(newKLine(b.high, b.low, 0, 0))
+	}
+
+	// ConversionPeriod=2 over the last two bars {12,9},{11,7}: (12+7)/2 = 9.5
+	almostEqual(t, "ConversionLine", inc.ConversionLine[len(inc.ConversionLine)-1], 9.5)
+
+	// BasePeriod=3 over all three bars: highest=12, lowest=7 -> (12+7)/2 = 9.5
+	almostEqual(t, "BaseLine", inc.BaseLine[len(inc.BaseLine)-1], 9.5)
+
+	// LeadingSpanA = (conversion+base)/2 = (9.5+9.5)/2 = 9.5
+	almostEqual(t, "LeadingSpanA", inc.Last(), 9.5)
+
+	// LeadingSpanB over LeadingSpanPeriod=3, same window as BaseLine here: 9.5
+	almostEqual(t, "LeadingSpanB", inc.LeadingSpanB[len(inc.LeadingSpanB)-1], 9.5)
+
+	if inc.Length() != 3 {
+		t.Fatalf("Length() = %d, want 3", inc.Length())
+	}
+}
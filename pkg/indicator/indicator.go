@@ -0,0 +1,39 @@
+package indicator
+
+import (
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// Indicator is the common interface implemented by every indicator in this
+// package (and by any user-defined indicator registered through
+// bbgo.StandardIndicatorSet.RegisterIndicator). It lets callers that only
+// care about the resulting series treat built-in and custom indicators the
+// same way.
+type Indicator interface {
+	// Bind subscribes the indicator to the given kline window updater so
+	// that it recomputes itself whenever a new kline is closed.
+	Bind(updater KLineWindowUpdater)
+
+	// Last returns the most recently computed value, or 0.0 if the
+	// indicator hasn't received enough data yet.
+	Last() float64
+
+	// Index returns the i-th value counting backwards from the latest one,
+	// i.e. Index(0) == Last().
+	Index(i int) float64
+
+	// Length returns the number of values the indicator has computed so far.
+	Length() int
+
+	// OnUpdate registers a callback that is fired with the newly computed
+	// value every time a closed kline updates the indicator, so that
+	// strategies can react to crossovers/threshold breaches without
+	// polling Last() from their own kline handler.
+	OnUpdate(cb func(value float64, kline types.KLine))
+
+	// IsReady returns true once the indicator has accumulated at least
+	// Window samples, so strategies can gate signal generation on it (e.g.
+	// until StandardIndicatorSet.Warmup has fed it enough historical data)
+	// instead of acting on an average that hasn't converged yet.
+	IsReady() bool
+}
@@ -0,0 +1,97 @@
+package indicator
+
+import (
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// MACD is the moving average convergence divergence indicator, consisting of
+// the MACD line (fast EMA - slow EMA), the signal line (EMA of the MACD
+// line) and the histogram (MACD line - signal line).
+// For more details, please check out the wikipedia page:
+// https://en.wikipedia.org/wiki/MACD
+type MACD struct {
+	types.IntervalWindow
+	updateNotifier
+
+	FastPeriod   int
+	SlowPeriod   int
+	SignalPeriod int
+
+	Values       types.Float64Slice
+	SignalValues types.Float64Slice
+	Histogram    types.Float64Slice
+
+	fastEMA, slowEMA, signalEMA float64
+	initialized                 bool
+}
+
+func ema(prev, price float64, period int) float64 {
+	multiplier := 2.0 / (float64(period) + 1.0)
+	return (price-prev)*multiplier + prev
+}
+
+func (inc *MACD) update(kLine types.KLine) {
+	closePrice := kLine.Close.Float64()
+
+	if !inc.initialized {
+		inc.fastEMA = closePrice
+		inc.slowEMA = closePrice
+		inc.initialized = true
+	} else {
+		inc.fastEMA = ema(inc.fastEMA, closePrice, inc.FastPeriod)
+		inc.slowEMA = ema(inc.slowEMA, closePrice, inc.SlowPeriod)
+	}
+
+	macd := inc.fastEMA - inc.slowEMA
+	inc.Values.Push(macd)
+
+	if len(inc.Values) == 1 {
+		inc.signalEMA = macd
+	} else {
+		inc.signalEMA = ema(inc.signalEMA, macd, inc.SignalPeriod)
+	}
+	inc.SignalValues.Push(inc.signalEMA)
+
+	inc.Histogram.Push(macd - inc.signalEMA)
+}
+
+func (inc *MACD) Last() float64 {
+	if len(inc.Values) == 0 {
+		return 0.0
+	}
+	return inc.Values[len(inc.Values)-1]
+}
+
+func (inc *MACD) Index(i int) float64 {
+	length := len(inc.Values)
+	if i >= length {
+		return 0.0
+	}
+	return inc.Values[length-i-1]
+}
+
+func (inc *MACD) Length() int {
+	return len(inc.Values)
+}
+
+// IsReady returns true once the indicator has accumulated at least Window
+// samples, so strategies can gate signal generation until the underlying
+// average has actually converged instead of acting on a single sample.
+func (inc *MACD) IsReady() bool {
+	return inc.Length() >= inc.Window
+}
+
+func (inc *MACD) Bind(updater KLineWindowUpdater) {
+	updater.OnKLineClosed(func(kLine types.KLine) {
+		if kLine.Interval != inc.Interval {
+			return
+		}
+
+		inc.update(kLine)
+		if inc.Length() == 0 {
+			return
+		}
+
+		inc.emit(inc.Last(), kLine)
+	})
+}
@@ -0,0 +1,39 @@
+package indicator
+
+import (
+	"testing"
+
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// TestMACD_Lines checks the MACD/signal/histogram lines against hand-computed
+// reference values. FastPeriod=1 makes the fast EMA track price exactly
+// (multiplier 1), and SlowPeriod=SignalPeriod=9 give a 0.2 multiplier, so the
+// expected values land on clean decimals.
+func TestMACD_Lines(t *testing.T) {
+	inc := &MACD{
+		IntervalWindow: types.IntervalWindow{Interval: types.Interval1m, Window: 9},
+		FastPeriod:     1,
+		SlowPeriod:     9,
+		SignalPeriod:   9,
+	}
+
+	closes := []float64{10, 20, 20, 30}
+	for _, c := range closes {
+		inc.update(closeAt(c))
+	}
+
+	wantMACD := []float64{0, 8, 6.4, 13.12}
+	wantSignal := []float64{0, 1.6, 2.56, 4.672}
+	wantHistogram := []float64{0, 6.4, 3.84, 8.448}
+
+	if got := len(inc.Values); got != len(wantMACD) {
+		t.Fatalf("len(Values) = %d, want %d", got, len(wantMACD))
+	}
+
+	for i := range wantMACD {
+		almostEqual(t, "MACD", inc.Values[i], wantMACD[i])
+		almostEqual(t, "Signal", inc.SignalValues[i], wantSignal[i])
+		almostEqual(t, "Histogram", inc.Histogram[i], wantHistogram[i])
+	}
+}
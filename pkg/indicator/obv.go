@@ -0,0 +1,83 @@
+package indicator
+
+import (
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// OBV is the On-Balance Volume indicator, a cumulative running total of
+// signed volume that rises or falls with the direction of the closing price.
+// For more details, please check out the wikipedia page:
+// https://en.wikipedia.org/wiki/On-balance_volume
+type OBV struct {
+	types.IntervalWindow
+	updateNotifier
+
+	Values types.Float64Slice
+
+	prevClose   float64
+	initialized bool
+}
+
+func (inc *OBV) update(kLine types.KLine) {
+	closePrice := kLine.Close.Float64()
+	volume := kLine.Volume.Float64()
+
+	if !inc.initialized {
+		inc.prevClose = closePrice
+		inc.initialized = true
+		inc.Values.Push(0.0)
+		return
+	}
+
+	obv := inc.Last()
+	switch {
+	case closePrice > inc.prevClose:
+		obv += volume
+	case closePrice < inc.prevClose:
+		obv -= volume
+	}
+
+	inc.prevClose = closePrice
+	inc.Values.Push(obv)
+}
+
+func (inc *OBV) Last() float64 {
+	if len(inc.Values) == 0 {
+		return 0.0
+	}
+	return inc.Values[len(inc.Values)-1]
+}
+
+func (inc *OBV) Index(i int) float64 {
+	length := len(inc.Values)
+	if i >= length {
+		return 0.0
+	}
+	return inc.Values[length-i-1]
+}
+
+func (inc *OBV) Length() int {
+	return len(inc.Values)
+}
+
+// IsReady returns true once the indicator has accumulated at least Window
+// samples, so strategies can gate signal generation until the underlying
+// average has actually converged instead of acting on a single sample.
+func (inc *OBV) IsReady() bool {
+	return inc.Length() >= inc.Window
+}
+
+func (inc *OBV) Bind(updater KLineWindowUpdater) {
+	updater.OnKLineClosed(func(kLine types.KLine) {
+		if kLine.Interval != inc.Interval {
+			return
+		}
+
+		inc.update(kLine)
+		if inc.Length() == 0 {
+			return
+		}
+
+		inc.emit(inc.Last(), kLine)
+	})
+}
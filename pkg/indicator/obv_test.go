@@ -0,0 +1,33 @@
+package indicator
+
+import (
+	"testing"
+
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// TestOBV_ReferenceValues checks OBV's running total against hand-computed
+// values: it starts at 0, adds the volume when the close rises, subtracts it
+// when the close falls, and carries over unchanged when the close is flat.
+func TestOBV_ReferenceValues(t *testing.T) {
+	inc := &OBV{IntervalWindow: types.IntervalWindow{Interval: types.Interval1m, Window: 3}}
+
+	inc.update(newKLine(0, 0, 10, 0)) // first bar just seeds prevClose, OBV starts at 0
+	almostEqual(t, "OBV[0]", inc.Last(), 0)
+
+	inc.update(newKLine(0, 0, 11, 5)) // close rose: +5
+	almostEqual(t, "OBV[1]", inc.Last(), 5)
+
+	inc.update(newKLine(0, 0, 11, 7)) // close flat: unchanged
+	almostEqual(t, "OBV[2]", inc.Last(), 5)
+
+	inc.update(newKLine(0, 0, 9, 3)) // close fell: -3
+	almostEqual(t, "OBV[3]", inc.Last(), 2)
+
+	if inc.Length() != 4 {
+		t.Fatalf("Length() = %d, want 4", inc.Length())
+	}
+	if !inc.IsReady() {
+		t.Errorf("IsReady() = false after Length() >= Window, want true")
+	}
+}
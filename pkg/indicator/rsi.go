@@ -0,0 +1,119 @@
+package indicator
+
+import (
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// RSI is the Relative Strength Index indicator.
+// For more details, please check out the wikipedia page:
+// https://en.wikipedia.org/wiki/Relative_strength_index
+type RSI struct {
+	types.IntervalWindow
+	updateNotifier
+
+	Values types.Float64Slice
+
+	prevClose   float64
+	initialized bool
+
+	// gainBuffer/lossBuffer accumulate the first Window gains/losses so the
+	// moving averages can be seeded from their simple average, as Wilder's
+	// method requires, instead of starting from a single period.
+	gainBuffer, lossBuffer []float64
+	avgGain, avgLoss       float64
+	seeded                 bool
+}
+
+func (inc *RSI) update(kLine types.KLine) {
+	closePrice := kLine.Close.Float64()
+
+	if !inc.initialized {
+		inc.prevClose = closePrice
+		inc.initialized = true
+		return
+	}
+
+	change := closePrice - inc.prevClose
+	inc.prevClose = closePrice
+
+	gain, loss := 0.0, 0.0
+	if change > 0 {
+		gain = change
+	} else {
+		loss = -change
+	}
+
+	if !inc.seeded {
+		inc.gainBuffer = append(inc.gainBuffer, gain)
+		inc.lossBuffer = append(inc.lossBuffer, loss)
+		if len(inc.gainBuffer) < inc.Window {
+			return
+		}
+
+		gainSum, lossSum := 0.0, 0.0
+		for _, v := range inc.gainBuffer {
+			gainSum += v
+		}
+		for _, v := range inc.lossBuffer {
+			lossSum += v
+		}
+		inc.avgGain = gainSum / float64(inc.Window)
+		inc.avgLoss = lossSum / float64(inc.Window)
+		inc.seeded = true
+		inc.gainBuffer, inc.lossBuffer = nil, nil
+	} else {
+		n := float64(inc.Window)
+		inc.avgGain = (inc.avgGain*(n-1) + gain) / n
+		inc.avgLoss = (inc.avgLoss*(n-1) + loss) / n
+	}
+
+	var rsi float64
+	if inc.avgLoss == 0 {
+		rsi = 100.0
+	} else {
+		rs := inc.avgGain / inc.avgLoss
+		rsi = 100.0 - (100.0 / (1.0 + rs))
+	}
+	inc.Values.Push(rsi)
+}
+
+func (inc *RSI) Last() float64 {
+	if len(inc.Values) == 0 {
+		return 0.0
+	}
+	return inc.Values[len(inc.Values)-1]
+}
+
+func (inc *RSI) Index(i int) float64 {
+	length := len(inc.Values)
+	if i >= length {
+		return 0.0
+	}
+	return inc.Values[length-i-1]
+}
+
+func (inc *RSI) Length() int {
+	return len(inc.Values)
+}
+
+// IsReady returns true once the indicator has accumulated at least Window
+// samples, so strategies can gate signal generation until the underlying
+// average has actually converged instead of acting on a single sample.
+func (inc *RSI) IsReady() bool {
+	return inc.Length() >= inc.Window
+}
+
+func (inc *RSI) Bind(updater KLineWindowUpdater) {
+	updater.OnKLineClosed(func(kLine types.KLine) {
+		if kLine.Interval != inc.Interval {
+			return
+		}
+
+		inc.update(kLine)
+		if inc.Length() == 0 {
+			return
+		}
+
+		inc.emit(inc.Last(), kLine)
+	})
+}
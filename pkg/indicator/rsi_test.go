@@ -0,0 +1,50 @@
+package indicator
+
+import (
+	"testing"
+
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// TestRSI_Seeding checks that the moving average is seeded from the simple
+// average of the first Window gains/losses (Wilder's method), not from a
+// single period.
+func TestRSI_Seeding(t *testing.T) {
+	inc := &RSI{IntervalWindow: types.IntervalWindow{Interval: types.Interval1m, Window: 4}}
+
+	closes := []float64{44, 44.25, 44.5, 43.75, 44.5}
+	for _, c := range closes {
+		inc.update(closeAt(c))
+	}
+
+	if inc.Length() != 1 {
+		t.Fatalf("Length() = %d, want 1 after exactly Window changes", inc.Length())
+	}
+
+	// avgGain = (0.25+0.25+0+0.75)/4 = 0.3125, avgLoss = (0+0+0.75+0)/4 = 0.1875
+	// rs = 0.3125/0.1875 = 5/3, rsi = 100 - 100/(1+5/3) = 62.5
+	almostEqual(t, "RSI", inc.Last(), 62.5)
+
+	if inc.IsReady() {
+		t.Errorf("IsReady() = true after a single computed value, want false until Length() >= Window")
+	}
+
+	for _, c := range []float64{45, 46, 47} {
+		inc.update(closeAt(c))
+	}
+	if !inc.IsReady() {
+		t.Errorf("IsReady() = false after %d computed values, want true", inc.Length())
+	}
+}
+
+// TestRSI_NoLosses checks that RSI reports exactly 100 (not ~99.01) when the
+// average loss is zero.
+func TestRSI_NoLosses(t *testing.T) {
+	inc := &RSI{IntervalWindow: types.IntervalWindow{Interval: types.Interval1m, Window: 3}}
+
+	for _, c := range []float64{10, 11, 12, 13} {
+		inc.update(closeAt(c))
+	}
+
+	almostEqual(t, "RSI", inc.Last(), 100.0)
+}
@@ -0,0 +1,72 @@
+package indicator
+
+import (
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// SMA is the Simple Moving Average indicator, the unweighted mean of the
+// close price over the trailing Window klines.
+// For more details, please check out the wikipedia page:
+// https://en.wikipedia.org/wiki/Moving_average#Simple_moving_average
+type SMA struct {
+	types.IntervalWindow
+	updateNotifier
+
+	Values types.Float64Slice
+
+	window types.Float64Slice
+}
+
+func (inc *SMA) update(kLine types.KLine) {
+	inc.window.Push(kLine.Close.Float64())
+	if len(inc.window) > inc.Window {
+		inc.window = inc.window[len(inc.window)-inc.Window:]
+	}
+
+	sum := 0.0
+	for _, v := range inc.window {
+		sum += v
+	}
+	inc.Values.Push(sum / float64(len(inc.window)))
+}
+
+func (inc *SMA) Last() float64 {
+	if len(inc.Values) == 0 {
+		return 0.0
+	}
+	return inc.Values[len(inc.Values)-1]
+}
+
+func (inc *SMA) Index(i int) float64 {
+	length := len(inc.Values)
+	if i >= length {
+		return 0.0
+	}
+	return inc.Values[length-i-1]
+}
+
+func (inc *SMA) Length() int {
+	return len(inc.Values)
+}
+
+// IsReady returns true once the indicator has accumulated at least Window
+// samples, so strategies can gate signal generation until the underlying
+// average has actually converged instead of acting on a single sample.
+func (inc *SMA) IsReady() bool {
+	return inc.Length() >= inc.Window
+}
+
+func (inc *SMA) Bind(updater KLineWindowUpdater) {
+	updater.OnKLineClosed(func(kLine types.KLine) {
+		if kLine.Interval != inc.Interval {
+			return
+		}
+
+		inc.update(kLine)
+		if inc.Length() == 0 {
+			return
+		}
+
+		inc.emit(inc.Last(), kLine)
+	})
+}
@@ -0,0 +1,112 @@
+package indicator
+
+import (
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// STOCH is the Stochastic Oscillator indicator, producing the %K and %D
+// lines. %D is the SignalPeriod-period simple moving average of %K.
+// For more details, please check out the wikipedia page:
+// https://en.wikipedia.org/wiki/Stochastic_oscillator
+type STOCH struct {
+	types.IntervalWindow
+	updateNotifier
+
+	SignalPeriod int
+
+	KValues types.Float64Slice
+	DValues types.Float64Slice
+
+	highs, lows, closes types.Float64Slice
+}
+
+func (inc *STOCH) update(kLine types.KLine) {
+	inc.highs.Push(kLine.High.Float64())
+	inc.lows.Push(kLine.Low.Float64())
+	inc.closes.Push(kLine.Close.Float64())
+
+	if len(inc.closes) > inc.Window {
+		inc.highs = inc.highs[len(inc.highs)-inc.Window:]
+		inc.lows = inc.lows[len(inc.lows)-inc.Window:]
+		inc.closes = inc.closes[len(inc.closes)-inc.Window:]
+	}
+
+	highestHigh := inc.highs[0]
+	lowestLow := inc.lows[0]
+	for _, h := range inc.highs {
+		if h > highestHigh {
+			highestHigh = h
+		}
+	}
+	for _, l := range inc.lows {
+		if l < lowestLow {
+			lowestLow = l
+		}
+	}
+
+	k := 50.0
+	if diff := highestHigh - lowestLow; diff != 0 {
+		k = (inc.closes[len(inc.closes)-1] - lowestLow) / diff * 100.0
+	}
+	inc.KValues.Push(k)
+
+	signalWindow := inc.KValues
+	if len(signalWindow) > inc.SignalPeriod {
+		signalWindow = signalWindow[len(signalWindow)-inc.SignalPeriod:]
+	}
+	sum := 0.0
+	for _, v := range signalWindow {
+		sum += v
+	}
+	inc.DValues.Push(sum / float64(len(signalWindow)))
+}
+
+// Last returns the latest %K value.
+func (inc *STOCH) Last() float64 {
+	if len(inc.KValues) == 0 {
+		return 0.0
+	}
+	return inc.KValues[len(inc.KValues)-1]
+}
+
+// LastD returns the latest %D value.
+func (inc *STOCH) LastD() float64 {
+	if len(inc.DValues) == 0 {
+		return 0.0
+	}
+	return inc.DValues[len(inc.DValues)-1]
+}
+
+func (inc *STOCH) Index(i int) float64 {
+	length := len(inc.KValues)
+	if i >= length {
+		return 0.0
+	}
+	return inc.KValues[length-i-1]
+}
+
+func (inc *STOCH) Length() int {
+	return len(inc.KValues)
+}
+
+// IsReady returns true once the indicator has accumulated at least Window
+// samples, so strategies can gate signal generation until the underlying
+// average has actually converged instead of acting on a single sample.
+func (inc *STOCH) IsReady() bool {
+	return inc.Length() >= inc.Window
+}
+
+func (inc *STOCH) Bind(updater KLineWindowUpdater) {
+	updater.OnKLineClosed(func(kLine types.KLine) {
+		if kLine.Interval != inc.Interval {
+			return
+		}
+
+		inc.update(kLine)
+		if inc.Length() == 0 {
+			return
+		}
+
+		inc.emit(inc.Last(), kLine)
+	})
+}
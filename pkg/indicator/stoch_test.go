@@ -0,0 +1,39 @@
+package indicator
+
+import (
+	"testing"
+
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// TestSTOCH_Lines checks the %K/%D lines against hand-computed reference
+// values for a rolling 3-bar high/low window and a 2-period %D average.
+func TestSTOCH_Lines(t *testing.T) {
+	inc := &STOCH{
+		IntervalWindow: types.IntervalWindow{Interval: types.Interval1m, Window: 3},
+		SignalPeriod:   2,
+	}
+
+	type bar struct{ high, low, close float64 }
+	bars := []bar{
+		{10, 0, 5},   // %K = (5-0)/(10-0)*100    = 50
+		{10, 0, 2.5}, // %K = (2.5-0)/(10-0)*100  = 25
+		{20, 0, 10},  // %K = (10-0)/(20-0)*100   = 50
+		{20, 0, 15},  // window rolls to bars 2-4: HH=20, LL=0, %K = 75
+	}
+	for _, b := range bars {
+		inc.update(newKLine(b.high, b.low, b.close, 0))
+	}
+
+	wantK := []float64{50, 25, 50, 75}
+	wantD := []float64{50, 37.5, 37.5, 62.5}
+
+	if got := len(inc.KValues); got != len(wantK) {
+		t.Fatalf("len(KValues) = %d, want %d", got, len(wantK))
+	}
+
+	for i := range wantK {
+		almostEqual(t, "%K", inc.KValues[i], wantK[i])
+		almostEqual(t, "%D", inc.DValues[i], wantD[i])
+	}
+}
@@ -0,0 +1,31 @@
+package indicator
+
+import (
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// KLineWindowUpdater is implemented by any market data cache (e.g. bbgo's
+// MarketDataStore) that can notify subscribers whenever a new kline for a
+// given interval is closed. Indicators bind to one of these instead of
+// depending on a concrete store type so that pkg/indicator never needs to
+// import pkg/bbgo.
+type KLineWindowUpdater interface {
+	OnKLineClosed(cb func(kline types.KLine))
+}
+
+// updateNotifier implements the OnUpdate half of the Indicator interface. It
+// is meant to be embedded into indicator structs so they only need to call
+// emit() once they've recomputed their value.
+type updateNotifier struct {
+	callbacks []func(value float64, kline types.KLine)
+}
+
+func (n *updateNotifier) OnUpdate(cb func(value float64, kline types.KLine)) {
+	n.callbacks = append(n.callbacks, cb)
+}
+
+func (n *updateNotifier) emit(value float64, kLine types.KLine) {
+	for _, cb := range n.callbacks {
+		cb(value, kLine)
+	}
+}
@@ -0,0 +1,91 @@
+package indicator
+
+import (
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// VWAP is the Volume Weighted Average Price indicator, rolling over the
+// configured window of klines.
+//
+// Note this is a rolling-window VWAP, not the canonical session-cumulative
+// VWAP that accumulates from the start of a trading session and resets at
+// session boundaries: Window behaves the same way it does for every other
+// indicator in this package (a trailing lookback), which also means VWAP
+// never resets on its own and will drift away from the exchange-reported
+// session VWAP the longer a session runs.
+// For more details, please check out the investopedia page:
+// https://www.investopedia.com/terms/v/vwap.asp
+type VWAP struct {
+	types.IntervalWindow
+	updateNotifier
+
+	Values types.Float64Slice
+
+	prices, volumes types.Float64Slice
+}
+
+func (inc *VWAP) update(kLine types.KLine) {
+	typicalPrice := (kLine.High.Float64() + kLine.Low.Float64() + kLine.Close.Float64()) / 3.0
+	volume := kLine.Volume.Float64()
+
+	inc.prices.Push(typicalPrice * volume)
+	inc.volumes.Push(volume)
+
+	if len(inc.prices) > inc.Window {
+		inc.prices = inc.prices[len(inc.prices)-inc.Window:]
+		inc.volumes = inc.volumes[len(inc.volumes)-inc.Window:]
+	}
+
+	sumPV, sumV := 0.0, 0.0
+	for i := range inc.prices {
+		sumPV += inc.prices[i]
+		sumV += inc.volumes[i]
+	}
+
+	vwap := 0.0
+	if sumV != 0 {
+		vwap = sumPV / sumV
+	}
+	inc.Values.Push(vwap)
+}
+
+func (inc *VWAP) Last() float64 {
+	if len(inc.Values) == 0 {
+		return 0.0
+	}
+	return inc.Values[len(inc.Values)-1]
+}
+
+func (inc *VWAP) Index(i int) float64 {
+	length := len(inc.Values)
+	if i >= length {
+		return 0.0
+	}
+	return inc.Values[length-i-1]
+}
+
+func (inc *VWAP) Length() int {
+	return len(inc.Values)
+}
+
+// IsReady returns true once the indicator has accumulated at least Window
+// samples, so strategies can gate signal generation until the underlying
+// average has actually converged instead of acting on a single sample.
+func (inc *VWAP) IsReady() bool {
+	return inc.Length() >= inc.Window
+}
+
+func (inc *VWAP) Bind(updater KLineWindowUpdater) {
+	updater.OnKLineClosed(func(kLine types.KLine) {
+		if kLine.Interval != inc.Interval {
+			return
+		}
+
+		inc.update(kLine)
+		if inc.Length() == 0 {
+			return
+		}
+
+		inc.emit(inc.Last(), kLine)
+	})
+}
@@ -0,0 +1,28 @@
+package indicator
+
+import (
+	"testing"
+
+	"github.com/c9s/bbgo/pkg/types"
+)
+
+// TestVWAP_RollingWindow checks VWAP's reference values over and past its
+// Window, confirming it is a rolling-window volume weighted average price
+// (typical price weighted by volume over the trailing Window klines) rather
+// than the canonical session-cumulative VWAP that accumulates from the
+// start of a trading session; see the doc comment on VWAP for why.
+func TestVWAP_RollingWindow(t *testing.T) {
+	inc := &VWAP{IntervalWindow: types.IntervalWindow{Interval: types.Interval1m, Window: 2}}
+
+	// typical price = (high+low+close)/3
+	inc.update(newKLine(12, 9, 9, 10)) // typical = 10, volume = 10
+	almostEqual(t, "VWAP[0]", inc.Last(), 10)
+
+	inc.update(newKLine(18, 15, 15, 20)) // typical = 16, volume = 20
+	// window = [(10,10), (16,20)] -> (10*10+16*20)/(10+20) = 420/30 = 14
+	almostEqual(t, "VWAP[1]", inc.Last(), 14)
+
+	inc.update(newKLine(21, 18, 18, 10)) // typical = 19, volume = 10; oldest kline drops out of the window
+	// window = [(16,20), (19,10)] -> (16*20+19*10)/(20+10) = 510/30 = 17
+	almostEqual(t, "VWAP[2]", inc.Last(), 17)
+}